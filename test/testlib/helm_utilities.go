@@ -1,7 +1,10 @@
 package testlib
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/gruntwork-io/terratest/modules/k8s"
 	"github.com/stretchr/testify/require"
 	"io"
@@ -19,19 +22,26 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
-/** Used to wait for all async calls of GetAppLog() routine to finish before the test finishes */
+// tailRetryInterval is how long TailAppLog waits before reconnecting a dropped
+// log stream or re-checking a pod that isn't ready to stream yet.
+const tailRetryInterval = 2 * time.Second
+
+/** Used to wait for all async calls of GetAppLogAsync()/TailAppLog() to finish before the test finishes */
 var appLogCollectorsWg sync.WaitGroup
 
 // GetAppLog
 /**
  * GetAppLog retrieves the log output from a specified pod within a Kubernetes namespace and writes it to a file.
  *
+ * GetAppLog does not touch appLogCollectorsWg itself, so a goroutine started with
+ * "go GetAppLog(...)" is not tracked and WaitForLogCollectors does not wait for it. Use
+ * GetAppLogAsync to collect a log in the background and have it tracked correctly.
  */
 func GetAppLog(t *testing.T, namespace string, podName string, fileNameSuffix string, podLogOptions *corev1.PodLogOptions) string {
-	defer appLogCollectorsWg.Done()
-	appLogCollectorsWg.Add(1)
 	dirPath := filepath.Join(RESULT_DIR, namespace)
 	filePath := filepath.Join(dirPath, podName+fileNameSuffix+".log")
 
@@ -49,6 +59,7 @@ func GetAppLog(t *testing.T, namespace string, podName string, fileNameSuffix st
 	// avoid generating test failure just because container logs are not available
 	if _, ok := err.(*ContainersNotStarted); ok {
 		t.Logf("Skipping log collection for pod %s because no container has been started", podName)
+		DumpNamespaceEvents(t, namespace, podName, time.Time{})
 		return ""
 	}
 	require.NoError(t, err)
@@ -58,9 +69,31 @@ func GetAppLog(t *testing.T, namespace string, podName string, fileNameSuffix st
 
 	t.Logf("Finished reading log file %s", filePath)
 
+	DumpNamespaceEvents(t, namespace, podName, time.Time{})
+
 	return filePath
 }
 
+// GetAppLogAsync
+/**
+ * GetAppLogAsync collects podName's log in the background the way "go GetAppLog(...)" looks
+ * like it should, but registers with appLogCollectorsWg before spawning the goroutine, so a
+ * subsequent WaitForLogCollectors call is guaranteed to wait for it instead of racing it.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param podName string - The name of the pod to collect the log from.
+ * @param fileNameSuffix string - Appended to podName to form the log file's name.
+ * @param podLogOptions *corev1.PodLogOptions - Options forwarded to GetAppLog.
+ */
+func GetAppLogAsync(t *testing.T, namespace string, podName string, fileNameSuffix string, podLogOptions *corev1.PodLogOptions) {
+	appLogCollectorsWg.Add(1)
+	go func() {
+		defer appLogCollectorsWg.Done()
+		GetAppLog(t, namespace, podName, fileNameSuffix, podLogOptions)
+	}()
+}
+
 func getAppLogStreamE(t *testing.T, namespace string, podName string, podLogOptions *corev1.PodLogOptions) (io.ReadCloser, error) {
 	options := k8s.NewKubectlOptions("", "", namespace)
 
@@ -95,6 +128,179 @@ func getAppLogStreamE(t *testing.T, namespace string, podName string, podLogOpti
 	return client.CoreV1().Pods(options.Namespace).GetLogs(podName, podLogOptions).Stream(context.TODO())
 }
 
+// TailAppLog
+/**
+ * TailAppLog opens a following log stream (PodLogOptions.Follow=true) for every container
+ * in a pod and writes each line to sink, prefixed with "podName/containerName". Unlike
+ * GetAppLog, which takes a one-shot snapshot, TailAppLog keeps the stream open for the
+ * lifetime of the test: it automatically reconnects when the API server drops the
+ * connection, and when a container restarts it first drains that container's Previous=true
+ * logs before resuming the follow, so no lines are lost across a CrashLoopBackOff cycle.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param podName string - The name of the pod to tail.
+ * @param podLogOptions *corev1.PodLogOptions - Base options applied to every container stream; Container and Follow are overridden per-container.
+ * @param sink io.Writer - Destination for prefixed log lines. Must tolerate concurrent writes from multiple containers.
+ *
+ * @return func() - Stops all tailing goroutines for this pod. Safe to call multiple times.
+ *
+ * Tailing goroutines register with appLogCollectorsWg and keep reconnecting until stopped;
+ * at test teardown call the returned stop function and then WaitForLogCollectors to make
+ * sure collection has actually flushed before the process exits.
+ */
+func TailAppLog(t *testing.T, namespace string, podName string, podLogOptions *corev1.PodLogOptions, sink io.Writer) func() {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, options)
+	require.NoError(t, err)
+
+	pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), podName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	stopCh := make(chan struct{})
+	var stopOnce sync.Once
+	var writeMu sync.Mutex
+
+	for _, container := range pod.Spec.Containers {
+		containerName := container.Name
+		appLogCollectorsWg.Add(1)
+		go tailContainerLog(t, client, namespace, podName, containerName, podLogOptions, sink, &writeMu, stopCh)
+	}
+
+	return func() {
+		stopOnce.Do(func() {
+			close(stopCh)
+		})
+	}
+}
+
+// WaitForLogCollectors blocks until every in-flight GetAppLogAsync and TailAppLog goroutine has
+// finished, or the timeout elapses. Intended for use from a TestMain teardown so log files
+// are guaranteed to be flushed to disk before the process exits.
+func WaitForLogCollectors(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		appLogCollectorsWg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// tailContainerLog follows a single container's log stream until stopCh is closed,
+// reconnecting on error and switching to Previous=true logs whenever the container's
+// restart count advances so a crash doesn't silently truncate output.
+func tailContainerLog(t *testing.T, client kubernetes.Interface, namespace string, podName string, containerName string, baseOptions *corev1.PodLogOptions, sink io.Writer, writeMu *sync.Mutex, stopCh <-chan struct{}) {
+	defer appLogCollectorsWg.Done()
+
+	// cancel an in-flight stream as soon as stopCh is closed, so stopping doesn't wait for a
+	// Follow:true stream on a still-running container to end on its own
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	prefix := podName + "/" + containerName
+	lastSeenRestarts := int32(-1)
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+
+		pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), podName, metav1.GetOptions{})
+		if err != nil {
+			t.Logf("%s: failed to get pod, retrying: %v", prefix, err)
+			if !sleepOrStop(tailRetryInterval, stopCh) {
+				return
+			}
+			continue
+		}
+
+		restarts := containerRestartCount(pod, containerName)
+		if lastSeenRestarts >= 0 && restarts > lastSeenRestarts {
+			prevOpts := *baseOptions
+			prevOpts.Container = containerName
+			prevOpts.Follow = false
+			prevOpts.Previous = true
+			streamToSink(ctx, client, namespace, podName, &prevOpts, prefix+" (previous)", sink, writeMu)
+		}
+		lastSeenRestarts = restarts
+
+		opts := *baseOptions
+		opts.Container = containerName
+		opts.Follow = true
+		opts.Previous = false
+
+		if err := streamToSink(ctx, client, namespace, podName, &opts, prefix, sink, writeMu); err != nil {
+			t.Logf("%s: log stream ended, reconnecting: %v", prefix, err)
+		}
+
+		if !sleepOrStop(tailRetryInterval, stopCh) {
+			return
+		}
+	}
+}
+
+// streamToSink copies every line from the requested pod log stream into sink, prefixing
+// each one with label. It returns once the stream is closed by the server (e.g. the
+// container restarted), errors, or ctx is canceled.
+func streamToSink(ctx context.Context, client kubernetes.Interface, namespace string, podName string, opts *corev1.PodLogOptions, label string, sink io.Writer, writeMu *sync.Mutex) error {
+	reader, err := client.CoreV1().Pods(namespace).GetLogs(podName, opts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		writeMu.Lock()
+		_, _ = fmt.Fprintf(sink, "%s: %s\n", label, scanner.Text())
+		writeMu.Unlock()
+	}
+	return scanner.Err()
+}
+
+// containerRestartCount returns the restart count reported for the named container (regular
+// or init), or 0 if the container's status isn't present yet (e.g. it hasn't started).
+func containerRestartCount(pod *corev1.Pod, containerName string) int32 {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName {
+			return status.RestartCount
+		}
+	}
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == containerName {
+			return status.RestartCount
+		}
+	}
+	return 0
+}
+
+// sleepOrStop waits for d, returning false early (without sleeping the full duration) if
+// stopCh is closed first.
+func sleepOrStop(d time.Duration, stopCh <-chan struct{}) bool {
+	select {
+	case <-stopCh:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
 func doesContainerHaveLogs(container *corev1.Container, containerStatuses []corev1.ContainerStatus) bool {
 	for _, status := range containerStatuses {
 		// check the status of the container; if it is in Waiting state,
@@ -154,6 +360,118 @@ func Await(t *testing.T, lmbd func() bool, timeout time.Duration) {
 	}
 }
 
+// PodSelector narrows AwaitPodCondition's polling to a subset of pods in a namespace.
+// NameContains preserves the existing substring-on-name convention used throughout this
+// package; LabelSelector and FieldSelector are passed straight through to the list call
+// for callers that have proper labels to select on.
+type PodSelector struct {
+	NameContains  string
+	LabelSelector string
+	FieldSelector string
+}
+
+// AtLeastOne is passed as AwaitPodCondition's wantCount when the caller only cares that
+// some matching pod satisfies predicate, regardless of how many pods the selector returns
+// in total (e.g. "has any pod with this name substring terminated yet?").
+const AtLeastOne = -1
+
+// AwaitPodCondition
+/**
+ * AwaitPodCondition polls the pods matching selector until wantCount of them satisfy
+ * predicate, or timeout elapses. It is the primitive the other Await* helpers in this file
+ * are built on.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param selector PodSelector - Restricts which pods are considered; NameContains, LabelSelector and FieldSelector may be combined.
+ * @param wantCount int - The exact number of pods that must satisfy predicate, or AtLeastOne to stop as soon as any do.
+ * @param predicate func(*corev1.Pod) (bool, error) - Evaluated against each selected pod on every poll.
+ * @param interval time.Duration - How long to wait between polls.
+ * @param timeout time.Duration - The maximum duration to wait before failing the test.
+ *
+ * @return []corev1.Pod - The pods that satisfied predicate on the poll that met wantCount.
+ *
+ * On timeout the test is failed with t.Fatalf, after dumping the last-observed phase,
+ * conditions, and container wait/termination reasons for every pod the selector matched, so
+ * the failure explains *why* pods weren't ready instead of just that they weren't.
+ */
+func AwaitPodCondition(t *testing.T, namespace string, selector PodSelector, wantCount int, predicate func(*corev1.Pod) (bool, error), interval time.Duration, timeout time.Duration) []corev1.Pod {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	listOptions := metav1.ListOptions{LabelSelector: selector.LabelSelector, FieldSelector: selector.FieldSelector}
+	start := time.Now()
+
+	var lastCandidates []corev1.Pod
+	var lastErr error
+
+	for timeExpired := time.After(timeout); ; {
+		select {
+		case <-timeExpired:
+			dumpAwaitPodConditionFailure(t, namespace, selector, lastCandidates, lastErr)
+			t.Fatalf("AwaitPodCondition: timed out after %f seconds waiting for %d pod(s) matching %+v in namespace %s. Start of await was '%s'", timeout.Seconds(), wantCount, selector, namespace, start)
+			return nil
+		default:
+		}
+
+		var candidates []corev1.Pod
+		for _, pod := range k8s.ListPods(t, options, listOptions) {
+			if selector.NameContains != "" && !strings.Contains(pod.Name, selector.NameContains) {
+				continue
+			}
+			candidates = append(candidates, pod)
+		}
+
+		var matched []corev1.Pod
+		lastErr = nil
+		for i := range candidates {
+			ok, err := predicate(&candidates[i])
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if ok {
+				matched = append(matched, candidates[i])
+			}
+		}
+		lastCandidates = candidates
+
+		t.Logf("%d/%d pod(s) matching %+v satisfy the condition in namespace %s", len(matched), len(candidates), selector, namespace)
+
+		if (wantCount == AtLeastOne && len(matched) > 0) || (wantCount >= 0 && len(matched) == wantCount) {
+			return matched
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// dumpAwaitPodConditionFailure logs the last-observed state of every candidate pod when
+// AwaitPodCondition times out.
+func dumpAwaitPodConditionFailure(t *testing.T, namespace string, selector PodSelector, pods []corev1.Pod, predicateErr error) {
+	if predicateErr != nil {
+		t.Logf("AwaitPodCondition: last predicate error: %v", predicateErr)
+	}
+	if len(pods) == 0 {
+		t.Logf("AwaitPodCondition: no pods in namespace %s matched selector %+v", namespace, selector)
+		DumpAllNamespaceEvents(t, namespace, time.Time{})
+		return
+	}
+	for _, pod := range pods {
+		t.Logf("pod %s: phase=%s", pod.Name, pod.Status.Phase)
+		for _, cond := range pod.Status.Conditions {
+			t.Logf("  condition %s=%s reason=%q message=%q", cond.Type, cond.Status, cond.Reason, cond.Message)
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Waiting != nil {
+				t.Logf("  container %s waiting: reason=%s message=%q", cs.Name, cs.State.Waiting.Reason, cs.State.Waiting.Message)
+			}
+			if cs.LastTerminationState.Terminated != nil {
+				t.Logf("  container %s last terminated: reason=%s exitCode=%d", cs.Name, cs.LastTerminationState.Terminated.Reason, cs.LastTerminationState.Terminated.ExitCode)
+			}
+		}
+		DumpNamespaceEvents(t, namespace, pod.Name, time.Time{})
+	}
+}
+
 // AwaitNrReplicasCreated
 /**
  * AwaitNrReplicasCreated waits until the specified number of replicas of a pod are created in the given namespace.
@@ -170,19 +488,9 @@ func AwaitNrReplicasCreated(t *testing.T, namespace string, expectedName string,
 	// the cluster might be downloading the docker images, so this might take a while the first time
 	timeout := 1 * time.Minute
 
-	Await(t, func() bool {
-		var pods []corev1.Pod
-		var podNames string
-		for _, pod := range FindAllPodsInSchema(t, namespace) {
-			if strings.Contains(pod.Name, expectedName) {
-				pods = append(pods, pod)
-			}
-		}
-
-		t.Logf("%d pods CREATED for name '%s': expected=%d, pods=[%s]\n", len(pods), expectedName, nrReplicas, podNames)
-
-		return len(pods) == nrReplicas
-	}, timeout)
+	AwaitPodCondition(t, namespace, PodSelector{NameContains: expectedName}, nrReplicas, func(pod *corev1.Pod) (bool, error) {
+		return true, nil
+	}, 1*time.Second, timeout)
 }
 
 // AwaitNrReplicasScheduled
@@ -192,47 +500,41 @@ func AwaitNrReplicasCreated(t *testing.T, namespace string, expectedName string,
  * @param t *testing.T - The testing context.
  * @param namespace string - The namespace of the Kubernetes cluster.
  * @param expectedName string - The expected name substring of the pods to check for.
- * @param nrReplicas int - The number of replicas expected to be scheduled.
+ * @param nrReplicas int - The number of replicas expected to be scheduled, not counting any pod that is scheduling-gated (see AwaitNrReplicasGated).
  *
  * The function waits for a maximum of 1 minute, checking once per second, to find the expected number of replicas that
  * are scheduled. If the expected number is found within the timeout, the function returns; otherwise, it logs the error.
+ *
+ * A pod with a non-empty Spec.SchedulingGates (KEP-3521) is intentionally withheld from the
+ * scheduler, so it is excluded from the count entirely: it counts neither as scheduled nor
+ * as a pod that failed to schedule.
  */
 func AwaitNrReplicasScheduled(t *testing.T, namespace string, expectedName string, nrReplicas int) {
 	// the cluster might be downloading the docker images, so this might take a while the first time
 	timeout := 1 * time.Minute
 
-	Await(t, func() bool {
-		var pods []corev1.Pod
-		var podNames string
-		for _, pod := range FindAllPodsInSchema(t, namespace) {
-			if strings.Contains(pod.Name, expectedName) {
-				//ignore all completed pods
-				if pod.Status.Phase == corev1.PodSucceeded {
-					continue
-				}
-
-				if arePodConditionsMet(&pod, corev1.PodScheduled, corev1.ConditionTrue) {
-					// build array of scheduled pods
-					pods = append(pods, pod)
-
-					// build formatted list of pod names
-					if podNames != "" {
-						podNames += ", "
-					}
-					podNames += pod.Name
-
-					// log any pods not in Pending or Running phase
-					if pod.Status.Phase != corev1.PodPending && pod.Status.Phase != corev1.PodRunning {
-						t.Logf("Unexpected phase for pod %s: %s", pod.Name, pod.Status.Phase)
-					}
-				}
-			}
+	AwaitPodCondition(t, namespace, PodSelector{NameContains: expectedName}, nrReplicas, func(pod *corev1.Pod) (bool, error) {
+		// ignore all completed pods
+		if pod.Status.Phase == corev1.PodSucceeded {
+			return false, nil
 		}
 
-		t.Logf("%d pods SCHEDULED for name '%s': expected=%d, pods=[%s]\n", len(pods), expectedName, nrReplicas, podNames)
+		// a scheduling gate intentionally holds this pod back; it's neither scheduled nor failed
+		if isPodSchedulingGated(pod) {
+			return false, nil
+		}
+
+		if !arePodConditionsMet(pod, corev1.PodScheduled, corev1.ConditionTrue) {
+			return false, nil
+		}
 
-		return len(pods) == nrReplicas
-	}, timeout)
+		// log any pods not in Pending or Running phase
+		if pod.Status.Phase != corev1.PodPending && pod.Status.Phase != corev1.PodRunning {
+			t.Logf("Unexpected phase for pod %s: %s", pod.Name, pod.Status.Phase)
+		}
+
+		return true, nil
+	}, 1*time.Second, timeout)
 }
 
 // AwaitNrReplicasReady
@@ -250,37 +552,32 @@ func AwaitNrReplicasScheduled(t *testing.T, namespace string, expectedName strin
 func AwaitNrReplicasReady(t *testing.T, namespace string, expectedName string, nrReplicas int) {
 	timeout := 30 * time.Second
 
-	Await(t, func() bool {
-		var cnt int
-		for _, pod := range FindAllPodsInSchema(t, namespace) {
-			if strings.Contains(pod.Name, expectedName) {
-				if arePodConditionsMet(&pod, corev1.PodReady, corev1.ConditionTrue) {
-					cnt++
-				}
-			}
-		}
-
-		t.Logf("%d pods READY for name '%s'\n", cnt, expectedName)
-
-		return cnt == nrReplicas
-	}, timeout)
+	AwaitPodCondition(t, namespace, PodSelector{NameContains: expectedName}, nrReplicas, func(pod *corev1.Pod) (bool, error) {
+		return arePodConditionsMet(pod, corev1.PodReady, corev1.ConditionTrue), nil
+	}, 1*time.Second, timeout)
 }
 
+// AwaitPodTerminated
+/**
+ * AwaitPodTerminated waits until any pod whose name contains expectedName reaches a
+ * terminal phase (Succeeded or Failed).
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param expectedName string - The expected name substring of the pod to check for.
+ *
+ * The function waits for a maximum of 30 seconds, checking once per second.
+ */
 func AwaitPodTerminated(t *testing.T, namespace string, expectedName string) {
 	timeout := 30 * time.Second
 
-	Await(t, func() bool {
-		for _, pod := range FindAllPodsInSchema(t, namespace) {
-			if strings.Contains(pod.Name, expectedName) {
-				// Check if pod is in terminal phase
-				if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
-					t.Logf("Pod %s has terminated with phase: %s", pod.Name, pod.Status.Phase)
-					return true
-				}
-			}
+	AwaitPodCondition(t, namespace, PodSelector{NameContains: expectedName}, AtLeastOne, func(pod *corev1.Pod) (bool, error) {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			t.Logf("Pod %s has terminated with phase: %s", pod.Name, pod.Status.Phase)
+			return true, nil
 		}
-		return false
-	}, timeout)
+		return false, nil
+	}, 1*time.Second, timeout)
 }
 
 // FindAllPodsInSchema
@@ -302,8 +599,16 @@ func FindAllPodsInSchema(t *testing.T, namespace string) []corev1.Pod {
 	return pods
 }
 
+// arePodConditionsMet reports whether pod has the given condition/status pair. A
+// PodScheduled check always reports false for a scheduling-gated pod (KEP-3521), regardless
+// of what the condition list says, since a gated pod's PodScheduled=False is an intentional
+// hold rather than a scheduling failure.
 func arePodConditionsMet(pod *corev1.Pod, condition corev1.PodConditionType,
 	status corev1.ConditionStatus) bool {
+	if condition == corev1.PodScheduled && isPodSchedulingGated(pod) {
+		return false
+	}
+
 	for _, cnd := range pod.Status.Conditions {
 		if cnd.Type == condition && cnd.Status == status {
 			return true
@@ -313,6 +618,75 @@ func arePodConditionsMet(pod *corev1.Pod, condition corev1.PodConditionType,
 	return false
 }
 
+// isPodSchedulingGated reports whether pod has at least one unmet PodSchedulingGate
+// (KEP-3521), meaning the scheduler is intentionally not considering it yet.
+func isPodSchedulingGated(pod *corev1.Pod) bool {
+	return len(pod.Spec.SchedulingGates) > 0
+}
+
+// AwaitNrReplicasGated
+/**
+ * AwaitNrReplicasGated waits until nrReplicas pods whose name contains expectedName are
+ * scheduling-gated (KEP-3521), i.e. each has a non-empty Spec.SchedulingGates. This lets
+ * charts that use gates for ordered rollout (e.g. "hold the node pods until the anvil chain
+ * pod is ready") assert the gate is actually in effect before calling RemoveSchedulingGate.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param expectedName string - The expected name substring of the pods to check for.
+ * @param nrReplicas int - The number of replicas expected to be gated.
+ *
+ * The function waits for a maximum of 1 minute, checking once per second.
+ */
+func AwaitNrReplicasGated(t *testing.T, namespace string, expectedName string, nrReplicas int) {
+	timeout := 1 * time.Minute
+
+	AwaitPodCondition(t, namespace, PodSelector{NameContains: expectedName}, nrReplicas, func(pod *corev1.Pod) (bool, error) {
+		return isPodSchedulingGated(pod), nil
+	}, 1*time.Second, timeout)
+}
+
+// RemoveSchedulingGate
+/**
+ * RemoveSchedulingGate patches podName to drop gateName from its Spec.SchedulingGates,
+ * releasing that one gate (KEP-3521) so the scheduler can consider the pod once its other
+ * gates, if any, are also clear.
+ *
+ * Uses a JSON patch that removes the single matching index rather than a Get-modify-Update
+ * of the whole pod, so it can't 409-conflict with a concurrent status write on the pod.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param podName string - The name of the pod to patch.
+ * @param gateName string - The gate to remove; a no-op if the pod doesn't have it.
+ */
+func RemoveSchedulingGate(t *testing.T, namespace string, podName string, gateName string) {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, options)
+	require.NoError(t, err)
+
+	pod, err := client.CoreV1().Pods(namespace).Get(context.TODO(), podName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	index := -1
+	for i, gate := range pod.Spec.SchedulingGates {
+		if gate.Name == gateName {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		t.Logf("Pod %s does not have scheduling gate %s; nothing to remove", podName, gateName)
+		return
+	}
+
+	patch := []byte(fmt.Sprintf(`[{"op":"remove","path":"/spec/schedulingGates/%d"}]`, index))
+	_, err = client.CoreV1().Pods(namespace).Patch(context.TODO(), podName, types.JSONPatchType, patch, metav1.PatchOptions{})
+	require.NoError(t, err)
+
+	t.Logf("Removed scheduling gate %s from pod %s", gateName, podName)
+}
+
 // FindPodsFromChart
 /**
  * FindPodsFromChart retrieves pods whose names contain the expected substring in the specified namespace.
@@ -408,6 +782,199 @@ func CreateJobFromCronJob(t *testing.T, namespace string, cronJob *v1.CronJob, n
 	return createdJob
 }
 
+// JobAttemptResult captures the outcome of one pod spawned by a Job: a Job with
+// restartPolicy: Never produces one pod per retry, and RunJobAndWait tracks each
+// separately instead of collapsing them into a single result.
+type JobAttemptResult struct {
+	PodName    string
+	Phase      corev1.PodPhase
+	ExitReason string
+	LogFile    string
+}
+
+// JobResult is returned by RunJobAndWait and RunJobFromCronJobAndWait.
+type JobResult struct {
+	Succeeded bool
+	Attempts  []JobAttemptResult
+}
+
+// RunJobAndWait
+/**
+ * RunJobAndWait creates job, watches every pod it spawns across restartPolicy: Never
+ * re-creations, streams each attempt's log into RESULT_DIR/<namespace>/<jobname>-<attempt>.log,
+ * and blocks until Job.Status.Succeeded >= Completions or Status.Failed > BackoffLimit.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param job *v1.Job - The Job to create; its Namespace is overwritten with namespace.
+ * @param timeout time.Duration - The maximum duration to wait for the Job to reach a terminal state.
+ *
+ * @return JobResult - Whether the Job succeeded, and the per-attempt detail behind that result.
+ */
+func RunJobAndWait(t *testing.T, namespace string, job *v1.Job, timeout time.Duration) JobResult {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, options)
+	require.NoError(t, err)
+
+	job = job.DeepCopy()
+	job.Namespace = namespace
+
+	createdJob, err := client.BatchV1().Jobs(namespace).Create(context.TODO(), job, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	t.Logf("Created Job %s/%s", namespace, createdJob.Name)
+	return watchJobToCompletion(t, client, namespace, createdJob, timeout)
+}
+
+// RunJobFromCronJobAndWait
+/**
+ * RunJobFromCronJobAndWait creates a Job from cronJob's template via CreateJobFromCronJob
+ * and then waits for it exactly as RunJobAndWait does.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param cronJob *v1.CronJob - The CronJob whose JobTemplateSpec is used to create the Job.
+ * @param newJobName string - The name of the Job to create.
+ * @param timeout time.Duration - The maximum duration to wait for the Job to reach a terminal state.
+ *
+ * @return JobResult - Whether the Job succeeded, and the per-attempt detail behind that result.
+ */
+func RunJobFromCronJobAndWait(t *testing.T, namespace string, cronJob *v1.CronJob, newJobName string, timeout time.Duration) JobResult {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, options)
+	require.NoError(t, err)
+
+	createdJob := CreateJobFromCronJob(t, namespace, cronJob, newJobName)
+	return watchJobToCompletion(t, client, namespace, createdJob, timeout)
+}
+
+// watchJobToCompletion polls job's pods and status until it reaches a terminal state,
+// streaming each new pod attempt's log to its own file as soon as the pod stops being Pending.
+//
+// The whole wait, including the in-flight Follow:true log streams collectJobAttemptLog keeps
+// open, is bounded by a single ctx derived from timeout: on expiry the context is canceled so
+// those streams are torn down and streamWg.Wait() returns promptly instead of blocking on
+// containers that are still running when timeout elapses.
+func watchJobToCompletion(t *testing.T, client kubernetes.Interface, namespace string, job *v1.Job, timeout time.Duration) JobResult {
+	options := k8s.NewKubectlOptions("", "", namespace)
+
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	backoffLimit := int32(6)
+	if job.Spec.BackoffLimit != nil {
+		backoffLimit = *job.Spec.BackoffLimit
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	attemptOf := map[string]int{}
+	attempts := map[string]*JobAttemptResult{}
+	var streamWg sync.WaitGroup
+	var mu sync.Mutex
+
+	start := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			cancel()
+			streamWg.Wait()
+			t.Fatalf("RunJobAndWait: Job %s/%s timed out after %f seconds. Start of await was '%s'", namespace, job.Name, timeout.Seconds(), start)
+			return JobResult{}
+		default:
+		}
+
+		current, err := client.BatchV1().Jobs(namespace).Get(ctx, job.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		pods := k8s.ListPods(t, options, metav1.ListOptions{LabelSelector: "job-name=" + job.Name})
+		sort.SliceStable(pods, func(i, j int) bool {
+			return pods[i].CreationTimestamp.Before(&pods[j].CreationTimestamp)
+		})
+
+		for _, pod := range pods {
+			if _, seen := attemptOf[pod.Name]; seen || pod.Status.Phase == corev1.PodPending {
+				continue
+			}
+			attempt := len(attemptOf) + 1
+			attemptOf[pod.Name] = attempt
+
+			podName := pod.Name
+			logFile := filepath.Join(RESULT_DIR, namespace, fmt.Sprintf("%s-%d.log", job.Name, attempt))
+
+			result := &JobAttemptResult{PodName: podName, LogFile: logFile}
+			attempts[podName] = result
+
+			streamWg.Add(1)
+			go func() {
+				defer streamWg.Done()
+				collectJobAttemptLog(ctx, t, client, namespace, podName, logFile, &mu, result)
+			}()
+		}
+
+		if current.Status.Succeeded >= completions {
+			streamWg.Wait()
+			return JobResult{Succeeded: true, Attempts: sortedJobAttempts(attempts, attemptOf)}
+		}
+		if current.Status.Failed > backoffLimit {
+			streamWg.Wait()
+			return JobResult{Succeeded: false, Attempts: sortedJobAttempts(attempts, attemptOf)}
+		}
+
+		time.Sleep(1 * time.Second)
+	}
+}
+
+// collectJobAttemptLog follows podName's log to logFile until the stream closes (the
+// container exits or ctx is canceled), then records its final phase and termination reason
+// into result. ctx bounds the Follow:true stream so a RunJobAndWait timeout can't be stuck
+// waiting on a pod that never exits.
+func collectJobAttemptLog(ctx context.Context, t *testing.T, client kubernetes.Interface, namespace string, podName string, logFile string, mu *sync.Mutex, result *JobAttemptResult) {
+	_ = os.MkdirAll(filepath.Dir(logFile), 0700)
+	f, err := os.Create(logFile)
+	if err != nil {
+		t.Logf("RunJobAndWait: failed to create log file %s: %v", logFile, err)
+		return
+	}
+	defer f.Close()
+
+	reader, err := client.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{Follow: true}).Stream(ctx)
+	if err != nil {
+		t.Logf("RunJobAndWait: failed to stream log for pod %s: %v", podName, err)
+	} else {
+		_, _ = io.Copy(f, reader)
+		_ = reader.Close()
+	}
+
+	// use a fresh context here: ctx may already be canceled (timeout/Job completion), but we
+	// still want a best-effort read of the pod's final state for the attempt result
+	pod, err := client.CoreV1().Pods(namespace).Get(context.Background(), podName, metav1.GetOptions{})
+	mu.Lock()
+	defer mu.Unlock()
+	if err != nil {
+		result.ExitReason = fmt.Sprintf("could not read final pod state: %v", err)
+		return
+	}
+	result.Phase = pod.Status.Phase
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.State.Terminated != nil {
+			result.ExitReason = cs.State.Terminated.Reason
+		}
+	}
+}
+
+// sortedJobAttempts returns the recorded attempts in attempt order, i.e. the order in which
+// their pods were first seen past Pending.
+func sortedJobAttempts(attempts map[string]*JobAttemptResult, attemptOf map[string]int) []JobAttemptResult {
+	ordered := make([]JobAttemptResult, len(attemptOf))
+	for podName, attempt := range attemptOf {
+		ordered[attempt-1] = *attempts[podName]
+	}
+	return ordered
+}
+
 func GetTerminatedPodLog(t *testing.T, namespace string, pod *corev1.Pod, fileNameSuffix string, podLogOptions *corev1.PodLogOptions) string {
 	// Determine if we need previous logs
 	for _, status := range pod.Status.ContainerStatuses {
@@ -449,5 +1016,254 @@ func GetTerminatedPodLog(t *testing.T, namespace string, pod *corev1.Pod, fileNa
 	require.NoError(t, err)
 
 	t.Logf("Finished reading log file %s", filePath)
+
+	DumpNamespaceEvents(t, namespace, pod.Name, time.Time{})
+
+	return filePath
+}
+
+// DumpNamespaceEvents
+/**
+ * DumpNamespaceEvents writes every Event in namespace whose involvedObject is the Pod
+ * named podName to RESULT_DIR/<namespace>/<podName>.events.log, sorted by LastTimestamp, one
+ * line per event: its LastTimestamp (RFC3339), a tab, then "Type Reason (x<count>) Message"
+ * in the manner of `kubectl describe`. It is
+ * called automatically by GetAppLog and GetTerminatedPodLog so a pod log always has a
+ * companion events log alongside it.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param podName string - The name of the pod whose events should be dumped.
+ * @param sinceTime time.Time - Only events at or after this time are included; pass the zero value for no lower bound.
+ *
+ * @return string - The path of the events log file written.
+ */
+func DumpNamespaceEvents(t *testing.T, namespace string, podName string, sinceTime time.Time) string {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, options)
+	require.NoError(t, err)
+
+	events, err := client.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod,involvedObject.name=" + podName,
+	})
+	require.NoError(t, err)
+
+	filePath := filepath.Join(RESULT_DIR, namespace, podName+".events.log")
+	writeEventsLog(t, filePath, filterEventsSince(events.Items, sinceTime))
 	return filePath
 }
+
+// DumpAllNamespaceEvents
+/**
+ * DumpAllNamespaceEvents writes every Event in namespace, regardless of involved object
+ * kind, to RESULT_DIR/<namespace>/events.log, sorted chronologically by LastTimestamp. Use
+ * this for a whole-namespace timeline instead of DumpNamespaceEvents' single-pod view.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param sinceTime time.Time - Only events at or after this time are included; pass the zero value for no lower bound.
+ *
+ * @return string - The path of the events log file written.
+ */
+func DumpAllNamespaceEvents(t *testing.T, namespace string, sinceTime time.Time) string {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, options)
+	require.NoError(t, err)
+
+	events, err := client.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+
+	filePath := filepath.Join(RESULT_DIR, namespace, "events.log")
+	writeEventsLog(t, filePath, filterEventsSince(events.Items, sinceTime))
+	return filePath
+}
+
+// filterEventsSince drops events that last fired before sinceTime; a zero sinceTime keeps everything.
+func filterEventsSince(events []corev1.Event, sinceTime time.Time) []corev1.Event {
+	if sinceTime.IsZero() {
+		return events
+	}
+	filtered := make([]corev1.Event, 0, len(events))
+	for _, event := range events {
+		if !event.LastTimestamp.Time.Before(sinceTime) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+// writeEventsLog sorts events by LastTimestamp and writes them to filePath, one per line as
+// "<LastTimestamp RFC3339>\tType Reason (x<count>) Message".
+func writeEventsLog(t *testing.T, filePath string, events []corev1.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+
+	_ = os.MkdirAll(filepath.Dir(filePath), 0700)
+	f, err := os.Create(filePath)
+	require.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+
+	for _, event := range events {
+		_, err := fmt.Fprintf(f, "%s\t%s %s (x%d) %s\n",
+			event.LastTimestamp.Format(time.RFC3339), event.Type, event.Reason, event.Count, event.Message)
+		require.NoError(t, err)
+	}
+
+	t.Logf("Finished writing events log %s", filePath)
+}
+
+// ContainerLogManifestEntry describes one container log collected by CollectAllLogs.
+type ContainerLogManifestEntry struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Previous  bool   `json:"previous"`
+	FilePath  string `json:"filePath"`
+	Bytes     int64  `json:"bytes"`
+	Truncated bool   `json:"truncated"`
+}
+
+// CollectOpts configures CollectAllLogs.
+type CollectOpts struct {
+	// Concurrency bounds how many container logs are fetched at once. Defaults to GOMAXPROCS.
+	Concurrency int
+	// MaxBytesPerContainer caps how much of any single container log is kept; 0 means unlimited.
+	MaxBytesPerContainer int64
+}
+
+// CollectAllLogs
+/**
+ * CollectAllLogs discovers every pod in namespace and fans log retrieval out across a
+ * bounded worker pool, collecting every container's log (init containers, sidecars, and the
+ * main container), plus the previous instance's log for any container that has restarted.
+ * It replaces the pattern of looping over FindPodsFromChart and calling GetAppLog per pod in
+ * ad-hoc goroutines, which only ever grabbed the single container findXmtpContainer picked.
+ *
+ * @param t *testing.T - The testing context.
+ * @param namespace string - The namespace of the Kubernetes cluster.
+ * @param opts CollectOpts - Tuning knobs; the zero value is a sane default (GOMAXPROCS workers, no truncation).
+ *
+ * @return []ContainerLogManifestEntry - One entry per pod/container (and previous instance) it tried to collect, also written as JSON to RESULT_DIR/<namespace>/manifest.json; a log that could not be fetched still has an entry, with Bytes 0.
+ */
+func CollectAllLogs(t *testing.T, namespace string, opts CollectOpts) []ContainerLogManifestEntry {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	type logJob struct {
+		podName       string
+		containerName string
+		previous      bool
+	}
+
+	var jobs []logJob
+	for _, pod := range FindAllPodsInSchema(t, namespace) {
+		containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+		for _, container := range containers {
+			jobs = append(jobs, logJob{pod.Name, container.Name, false})
+			if containerRestartCount(&pod, container.Name) > 0 {
+				jobs = append(jobs, logJob{pod.Name, container.Name, true})
+			}
+		}
+	}
+
+	jobCh := make(chan logJob)
+	resultCh := make(chan ContainerLogManifestEntry, len(jobs))
+	var workers sync.WaitGroup
+
+	for i := 0; i < concurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for j := range jobCh {
+				resultCh <- collectContainerLog(t, namespace, j.podName, j.containerName, j.previous, opts.MaxBytesPerContainer)
+			}
+		}()
+	}
+
+	for _, j := range jobs {
+		jobCh <- j
+	}
+	close(jobCh)
+	workers.Wait()
+	close(resultCh)
+
+	manifest := make([]ContainerLogManifestEntry, 0, len(jobs))
+	for entry := range resultCh {
+		manifest = append(manifest, entry)
+	}
+	sort.SliceStable(manifest, func(i, j int) bool {
+		if manifest[i].Pod != manifest[j].Pod {
+			return manifest[i].Pod < manifest[j].Pod
+		}
+		if manifest[i].Container != manifest[j].Container {
+			return manifest[i].Container < manifest[j].Container
+		}
+		return !manifest[i].Previous && manifest[j].Previous
+	})
+
+	manifestPath := filepath.Join(RESULT_DIR, namespace, "manifest.json")
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	require.NoError(t, err)
+	_ = os.MkdirAll(filepath.Dir(manifestPath), 0700)
+	require.NoError(t, os.WriteFile(manifestPath, data, 0600))
+
+	t.Logf("CollectAllLogs: wrote manifest for %d container log(s) to %s", len(manifest), manifestPath)
+
+	return manifest
+}
+
+// collectContainerLog fetches a single container's (or, if previous is true, its previous
+// instance's) log into RESULT_DIR/<namespace>/<pod>-<container>[-previous].log.
+func collectContainerLog(t *testing.T, namespace string, podName string, containerName string, previous bool, maxBytes int64) ContainerLogManifestEntry {
+	options := k8s.NewKubectlOptions("", "", namespace)
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, options)
+	require.NoError(t, err)
+
+	suffix := ""
+	if previous {
+		suffix = "-previous"
+	}
+	filePath := filepath.Join(RESULT_DIR, namespace, fmt.Sprintf("%s-%s%s.log", podName, containerName, suffix))
+	entry := ContainerLogManifestEntry{Pod: podName, Container: containerName, Previous: previous, FilePath: filePath}
+
+	_ = os.MkdirAll(filepath.Dir(filePath), 0700)
+	f, err := os.Create(filePath)
+	if err != nil {
+		t.Logf("CollectAllLogs: failed to create %s: %v", filePath, err)
+		return entry
+	}
+	defer f.Close()
+
+	reader, err := client.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{Container: containerName, Previous: previous}).Stream(context.TODO())
+	if err != nil {
+		t.Logf("CollectAllLogs: failed to stream %s/%s (previous=%t): %v", podName, containerName, previous, err)
+		return entry
+	}
+	defer reader.Close()
+
+	if maxBytes > 0 {
+		written, err := io.CopyN(f, reader, maxBytes)
+		entry.Bytes = written
+		switch {
+		case err == nil:
+			// io.CopyN only returns a nil error once it has copied exactly maxBytes.
+			entry.Truncated = true
+		case err == io.EOF:
+			// stream ended on its own before reaching maxBytes; not truncated.
+		default:
+			t.Logf("CollectAllLogs: error copying %s/%s log: %v", podName, containerName, err)
+		}
+	} else {
+		written, err := io.Copy(f, reader)
+		entry.Bytes = written
+		if err != nil {
+			t.Logf("CollectAllLogs: error copying %s/%s log: %v", podName, containerName, err)
+		}
+	}
+
+	return entry
+}